@@ -0,0 +1,52 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// Item represents a single owned item tracked by the collection.
+type Item struct {
+	ID            int64     `json:"id"`
+	Name          string    `json:"name"`
+	Category      string    `json:"category"`
+	Brand         string    `json:"brand"`
+	PurchasePrice int       `json:"purchase_price"`
+	PurchaseDate  string    `json:"purchase_date"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// NewItem builds an Item from raw input, validating required fields.
+func NewItem(name, category, brand string, purchasePrice int, purchaseDate string) (*Item, error) {
+	now := time.Now()
+	item := &Item{
+		Name:          name,
+		Category:      category,
+		Brand:         brand,
+		PurchasePrice: purchasePrice,
+		PurchaseDate:  purchaseDate,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	if err := item.Validate(); err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// Validate checks the invariants every Item must satisfy, whether it was
+// just constructed by NewItem or patched from an existing row.
+func (i *Item) Validate() error {
+	if strings.TrimSpace(i.Name) == "" {
+		return domainErrors.ErrInvalidInput
+	}
+	if i.PurchasePrice < 0 {
+		return domainErrors.ErrInvalidInput
+	}
+	return nil
+}
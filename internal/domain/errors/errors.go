@@ -0,0 +1,10 @@
+package errors
+
+import "errors"
+
+var (
+	// ErrItemNotFound is returned when an item cannot be located by ID.
+	ErrItemNotFound = errors.New("item not found")
+	// ErrInvalidInput is returned when the fields supplied for an item fail validation.
+	ErrInvalidInput = errors.New("invalid input")
+)
@@ -0,0 +1,57 @@
+package database
+
+import (
+	"context"
+
+	infradb "Aicon-assignment/internal/interfaces/database"
+	"Aicon-assignment/internal/usecase"
+)
+
+// UnitOfWork is the usecase.UnitOfWork port backed by a real SqlHandler. Each
+// call to Do runs on its own transaction so callers never share state across
+// concurrent operations.
+type UnitOfWork struct {
+	handler infradb.SqlHandler
+}
+
+// NewUnitOfWork builds a UnitOfWork that begins transactions on handler.
+func NewUnitOfWork(handler infradb.SqlHandler) *UnitOfWork {
+	return &UnitOfWork{handler: handler}
+}
+
+// Do runs fn inside a transaction against handler, passing it a repository
+// scoped to that transaction. The transaction commits if fn returns nil and
+// rolls back otherwise; a rollback failure is returned in place of fn's error
+// so callers aren't left believing the rollback succeeded. If fn panics, the
+// transaction is rolled back before the panic is re-raised so a bug in a
+// future usecase can't leak an open connection from the pool.
+func (u *UnitOfWork) Do(ctx context.Context, fn func(ctx context.Context, repo usecase.ItemRepository) error) error {
+	tx, err := u.handler.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	done := false
+	defer func() {
+		if done {
+			return
+		}
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	repo := infradb.NewItemRepository(tx)
+
+	if err := fn(ctx, repo); err != nil {
+		done = true
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return rbErr
+		}
+		return err
+	}
+
+	done = true
+	return tx.Commit()
+}
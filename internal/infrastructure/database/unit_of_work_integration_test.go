@@ -0,0 +1,70 @@
+//go:build integration
+
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"Aicon-assignment/internal/domain/entity"
+	infradb "Aicon-assignment/internal/interfaces/database"
+	"Aicon-assignment/internal/testutil"
+	"Aicon-assignment/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitOfWork_CommitsOnSuccess(t *testing.T) {
+	db := testutil.OpenDB(t)
+	testutil.ResetItemsTable(t, db)
+
+	uow := NewUnitOfWork(&testutil.SqlHandler{DB: db})
+	ctx := context.Background()
+
+	item, err := entity.NewItem("テスト", "時計", "ROLEX", 1000, "2023-01-01")
+	require.NoError(t, err)
+
+	var created *entity.Item
+	err = uow.Do(ctx, func(ctx context.Context, repo usecase.ItemRepository) error {
+		c, err := repo.Create(ctx, item)
+		if err != nil {
+			return err
+		}
+		created = c
+		return nil
+	})
+	require.NoError(t, err)
+	require.NotNil(t, created)
+
+	repo := infradb.NewItemRepository(&testutil.SqlHandler{DB: db})
+	fetched, err := repo.FindByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "テスト", fetched.Name)
+}
+
+func TestUnitOfWork_RollsBackOnError(t *testing.T) {
+	db := testutil.OpenDB(t)
+	testutil.ResetItemsTable(t, db)
+
+	uow := NewUnitOfWork(&testutil.SqlHandler{DB: db})
+	ctx := context.Background()
+
+	item, err := entity.NewItem("ロールバック対象", "時計", "ROLEX", 1000, "2023-01-01")
+	require.NoError(t, err)
+
+	wantErr := errors.New("boom")
+	err = uow.Do(ctx, func(ctx context.Context, repo usecase.ItemRepository) error {
+		if _, err := repo.Create(ctx, item); err != nil {
+			return err
+		}
+		return wantErr
+	})
+	assert.ErrorIs(t, err, wantErr)
+
+	repo := infradb.NewItemRepository(&testutil.SqlHandler{DB: db})
+	items, err := repo.FindAll(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, items)
+}
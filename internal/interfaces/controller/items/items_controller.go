@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	domainErrors "Aicon-assignment/internal/domain/errors"
+	"Aicon-assignment/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ErrorResponse is the JSON body returned for any failed request.
+type ErrorResponse struct {
+	Error   string   `json:"error"`
+	Details []string `json:"details,omitempty"`
+}
+
+// ItemHandler exposes the item usecase over HTTP.
+type ItemHandler struct {
+	usecase usecase.ItemUsecase
+}
+
+// NewItemHandler constructs an ItemHandler backed by the given usecase.
+func NewItemHandler(u usecase.ItemUsecase) *ItemHandler {
+	return &ItemHandler{usecase: u}
+}
+
+func (h *ItemHandler) GetAllItems(c echo.Context) error {
+	items, err := h.usecase.GetAllItems(c.Request().Context())
+	if err != nil {
+		return h.handleError(c, err)
+	}
+	return c.JSON(http.StatusOK, items)
+}
+
+func (h *ItemHandler) GetItemByID(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid item ID"})
+	}
+
+	item, err := h.usecase.GetItemByID(c.Request().Context(), id)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+	return c.JSON(http.StatusOK, item)
+}
+
+type createItemRequest struct {
+	Name          string `json:"name"`
+	Category      string `json:"category"`
+	Brand         string `json:"brand"`
+	PurchasePrice int    `json:"purchase_price"`
+	PurchaseDate  string `json:"purchase_date"`
+}
+
+func (h *ItemHandler) CreateItem(c echo.Context) error {
+	var req createItemRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid request format"})
+	}
+
+	item, err := h.usecase.CreateItem(c.Request().Context(), usecase.CreateItemInput{
+		Name:          req.Name,
+		Category:      req.Category,
+		Brand:         req.Brand,
+		PurchasePrice: req.PurchasePrice,
+		PurchaseDate:  req.PurchaseDate,
+	})
+	if err != nil {
+		return h.handleError(c, err)
+	}
+	return c.JSON(http.StatusCreated, item)
+}
+
+func (h *ItemHandler) DeleteItem(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid item ID"})
+	}
+
+	if err := h.usecase.DeleteItem(c.Request().Context(), id); err != nil {
+		return h.handleError(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *ItemHandler) GetCategorySummary(c echo.Context) error {
+	summary, err := h.usecase.GetCategorySummary(c.Request().Context())
+	if err != nil {
+		return h.handleError(c, err)
+	}
+	return c.JSON(http.StatusOK, summary)
+}
+
+func (h *ItemHandler) PatchItem(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid item ID"})
+	}
+
+	var input usecase.PatchItemInput
+	if err := c.Bind(&input); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid request format"})
+	}
+
+	item, err := h.usecase.PatchItem(c.Request().Context(), id, input)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+	return c.JSON(http.StatusOK, item)
+}
+
+func (h *ItemHandler) handleError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, domainErrors.ErrItemNotFound):
+		return c.JSON(http.StatusNotFound, ErrorResponse{Error: "item not found"})
+	case errors.Is(err, domainErrors.ErrInvalidInput):
+		return c.JSON(http.StatusBadRequest, ErrorResponse{Error: "validation failed", Details: []string{err.Error()}})
+	default:
+		return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "internal server error"})
+	}
+}
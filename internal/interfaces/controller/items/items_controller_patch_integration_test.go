@@ -0,0 +1,120 @@
+//go:build integration
+
+package controller
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"Aicon-assignment/internal/domain/entity"
+	infrastructuredb "Aicon-assignment/internal/infrastructure/database"
+	infradb "Aicon-assignment/internal/interfaces/database"
+	"Aicon-assignment/internal/testutil"
+	"Aicon-assignment/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func newIntegrationHandler(db *sql.DB) *ItemHandler {
+	handler := &testutil.SqlHandler{DB: db}
+	repo := infradb.NewItemRepository(handler)
+	uow := infrastructuredb.NewUnitOfWork(handler)
+	return NewItemHandler(usecase.NewItemUsecase(repo, uow))
+}
+
+func doPatch(h *ItemHandler, idParam, body string) *httptest.ResponseRecorder {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPatch, "/items/"+idParam, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(idParam)
+
+	_ = h.PatchItem(c)
+	return rec
+}
+
+func TestPatchItem_Integration_PartialUpdate(t *testing.T) {
+	db := testutil.OpenDB(t)
+	testutil.ResetItemsTable(t, db)
+
+	repo := infradb.NewItemRepository(&testutil.SqlHandler{DB: db})
+	seed, err := entity.NewItem("元の名前", "時計", "ROLEX", 1000, "2023-01-01")
+	require.NoError(t, err)
+	created, err := repo.Create(context.Background(), seed)
+	require.NoError(t, err)
+
+	h := newIntegrationHandler(db)
+	rec := doPatch(h, strconv.FormatInt(created.ID, 10), `{"name":"更新後"}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var got entity.Item
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&got))
+	require.Equal(t, "更新後", got.Name)
+	require.Equal(t, "時計", got.Category)
+	require.Equal(t, "ROLEX", got.Brand)
+	require.Equal(t, 1000, got.PurchasePrice)
+	require.Equal(t, "2023-01-01", got.PurchaseDate)
+
+	fetched, err := repo.FindByID(context.Background(), created.ID)
+	require.NoError(t, err)
+	require.Equal(t, "更新後", fetched.Name)
+	require.Equal(t, "時計", fetched.Category)
+	require.True(t, fetched.UpdatedAt.After(created.UpdatedAt), "PatchItem must bump updated_at")
+}
+
+func TestPatchItem_Integration_NotFound(t *testing.T) {
+	db := testutil.OpenDB(t)
+	testutil.ResetItemsTable(t, db)
+
+	h := newIntegrationHandler(db)
+	rec := doPatch(h, "999999", `{"name":"更新後"}`)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	var got ErrorResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&got))
+	require.Equal(t, "item not found", got.Error)
+}
+
+func TestPatchItem_Integration_ConcurrentPatches(t *testing.T) {
+	db := testutil.OpenDB(t)
+	testutil.ResetItemsTable(t, db)
+
+	repo := infradb.NewItemRepository(&testutil.SqlHandler{DB: db})
+	h := newIntegrationHandler(db)
+
+	const n = 5
+	ids := make([]int64, n)
+	for i := 0; i < n; i++ {
+		seed, err := entity.NewItem(fmt.Sprintf("アイテム%d", i), "時計", "ROLEX", 1000, "2023-01-01")
+		require.NoError(t, err)
+		created, err := repo.Create(context.Background(), seed)
+		require.NoError(t, err)
+		ids[i] = created.ID
+	}
+
+	for i := 0; i < n; i++ {
+		i, id := i, ids[i]
+		t.Run(fmt.Sprintf("item-%d", i), func(t *testing.T) {
+			t.Parallel()
+
+			newName := fmt.Sprintf("更新済み%d", i)
+			rec := doPatch(h, strconv.FormatInt(id, 10), fmt.Sprintf(`{"name":%q}`, newName))
+			require.Equal(t, http.StatusOK, rec.Code)
+
+			fetched, err := repo.FindByID(context.Background(), id)
+			require.NoError(t, err)
+			require.Equal(t, newName, fetched.Name)
+			require.Equal(t, "時計", fetched.Category)
+		})
+	}
+}
@@ -1,7 +1,6 @@
 package controller
 
 import (
-	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -11,77 +10,104 @@ import (
 
 	"Aicon-assignment/internal/domain/entity"
 	domainErrors "Aicon-assignment/internal/domain/errors"
+	"Aicon-assignment/internal/mocks"
 	"Aicon-assignment/internal/usecase"
 
 	"github.com/labstack/echo/v4"
-	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
-	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
 )
 
-type MockItemUsecase struct {
-	mock.Mock
+// ItemHandlerTestSuite exercises ItemHandler against a fresh mock usecase for
+// each test case, avoiding the setup boilerplate a plain table test would
+// otherwise repeat.
+type ItemHandlerTestSuite struct {
+	suite.Suite
+
+	echo        *echo.Echo
+	mockUsecase *mocks.ItemUsecase
+	handler     *ItemHandler
 }
 
-func (m *MockItemUsecase) GetAllItems(ctx context.Context) ([]*entity.Item, error) {
-	args := m.Called(ctx)
-	if items, ok := args.Get(0).([]*entity.Item); ok {
-		return items, args.Error(1)
-	}
-	return nil, args.Error(1)
+func (s *ItemHandlerTestSuite) SetupTest() {
+	s.echo = echo.New()
+	s.mockUsecase = new(mocks.ItemUsecase)
+	s.handler = NewItemHandler(s.mockUsecase)
 }
 
-func (m *MockItemUsecase) GetItemByID(ctx context.Context, id int64) (*entity.Item, error) {
-	args := m.Called(ctx, id)
-	if item, ok := args.Get(0).(*entity.Item); ok {
-		return item, args.Error(1)
-	}
-	return nil, args.Error(1)
+func (s *ItemHandlerTestSuite) TearDownTest() {
+	s.mockUsecase.AssertExpectations(s.T())
 }
 
-func (m *MockItemUsecase) CreateItem(ctx context.Context, input usecase.CreateItemInput) (*entity.Item, error) {
-	args := m.Called(ctx, input)
-	if item, ok := args.Get(0).(*entity.Item); ok {
-		return item, args.Error(1)
-	}
-	return nil, args.Error(1)
+func (s *ItemHandlerTestSuite) newPatchContext(body, idParam string) (echo.Context, *httptest.ResponseRecorder) {
+	s.T().Helper()
+
+	req := httptest.NewRequest(http.MethodPatch, "/items/"+idParam, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(idParam)
+	return c, rec
 }
 
-func (m *MockItemUsecase) DeleteItem(ctx context.Context, id int64) error {
-	args := m.Called(ctx, id)
-	return args.Error(0)
+func (s *ItemHandlerTestSuite) TestPatchItem_InvalidID() {
+	c, rec := s.newPatchContext(`{"name":"Updated"}`, "abc")
+
+	s.Require().NoError(s.handler.PatchItem(c))
+	s.Equal(http.StatusBadRequest, rec.Code)
+
+	var got ErrorResponse
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&got))
+	s.Equal("invalid item ID", got.Error)
+
+	s.mockUsecase.AssertNotCalled(s.T(), "PatchItem", mock.Anything, mock.Anything, mock.Anything)
 }
 
-func (m *MockItemUsecase) GetCategorySummary(ctx context.Context) (*usecase.CategorySummary, error) {
-	args := m.Called(ctx)
-	if summary, ok := args.Get(0).(*usecase.CategorySummary); ok {
-		return summary, args.Error(1)
-	}
-	return nil, args.Error(1)
+func (s *ItemHandlerTestSuite) TestPatchItem_InvalidJSON() {
+	c, rec := s.newPatchContext(`{`, "1")
+
+	s.Require().NoError(s.handler.PatchItem(c))
+	s.Equal(http.StatusBadRequest, rec.Code)
+
+	var got ErrorResponse
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&got))
+	s.Equal("invalid request format", got.Error)
+
+	s.mockUsecase.AssertNotCalled(s.T(), "PatchItem", mock.Anything, mock.Anything, mock.Anything)
 }
 
-func (m *MockItemUsecase) PatchItem(ctx context.Context, id int64, input usecase.PatchItemInput) (*entity.Item, error) {
-	args := m.Called(ctx, id, input)
-	if item, ok := args.Get(0).(*entity.Item); ok {
-		return item, args.Error(1)
-	}
-	return nil, args.Error(1)
+func (s *ItemHandlerTestSuite) TestPatchItem_NotFound() {
+	s.mockUsecase.On("PatchItem", mock.Anything, int64(1), mock.MatchedBy(func(in usecase.PatchItemInput) bool {
+		return in.Name != nil && *in.Name == "Updated"
+	})).Return((*entity.Item)(nil), domainErrors.ErrItemNotFound)
+
+	c, rec := s.newPatchContext(`{"name":"Updated"}`, "1")
+
+	s.Require().NoError(s.handler.PatchItem(c))
+	s.Equal(http.StatusNotFound, rec.Code)
+
+	var got ErrorResponse
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&got))
+	s.Equal("item not found", got.Error)
 }
 
-func newPatchContext(t *testing.T, body string, idParam string) (echo.Context, *httptest.ResponseRecorder) {
-	t.Helper()
+func (s *ItemHandlerTestSuite) TestPatchItem_ValidationError() {
+	s.mockUsecase.On("PatchItem", mock.Anything, int64(1), mock.Anything).
+		Return((*entity.Item)(nil), domainErrors.ErrInvalidInput)
 
-	e := echo.New()
-	req := httptest.NewRequest(http.MethodPatch, "/items/"+idParam, strings.NewReader(body))
-	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
-	rec := httptest.NewRecorder()
-	c := e.NewContext(req, rec)
-	c.SetParamNames("id")
-	c.SetParamValues(idParam)
-	return c, rec
+	c, rec := s.newPatchContext(`{"name":""}`, "1")
+
+	s.Require().NoError(s.handler.PatchItem(c))
+	s.Equal(http.StatusBadRequest, rec.Code)
+
+	var got ErrorResponse
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&got))
+	s.Equal("validation failed", got.Error)
+	s.Equal([]string{domainErrors.ErrInvalidInput.Error()}, got.Details)
 }
 
-func TestItemHandler_PatchItem(t *testing.T) {
+func (s *ItemHandlerTestSuite) TestPatchItem_Success() {
 	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
 	updatedItem := &entity.Item{
 		ID:            1,
@@ -94,107 +120,25 @@ func TestItemHandler_PatchItem(t *testing.T) {
 		UpdatedAt:     now,
 	}
 
-	tests := []struct {
-		name         string
-		idParam      string
-		body         string
-		setupMock    func(*MockItemUsecase)
-		wantStatus   int
-		wantError    *ErrorResponse
-		wantItem     *entity.Item
-		assertNoCall bool
-	}{
-		{
-			name:    "invalid id",
-			idParam: "abc",
-			body:    `{"name":"Updated"}`,
-			setupMock: func(_ *MockItemUsecase) {
-			},
-			wantStatus:   http.StatusBadRequest,
-			wantError:    &ErrorResponse{Error: "invalid item ID"},
-			assertNoCall: true,
-		},
-		{
-			name:    "invalid json",
-			idParam: "1",
-			body:    `{`,
-			setupMock: func(_ *MockItemUsecase) {
-			},
-			wantStatus:   http.StatusBadRequest,
-			wantError:    &ErrorResponse{Error: "invalid request format"},
-			assertNoCall: true,
-		},
-		{
-			name:    "not found",
-			idParam: "1",
-			body:    `{"name":"Updated"}`,
-			setupMock: func(m *MockItemUsecase) {
-				m.On("PatchItem", mock.Anything, int64(1), mock.MatchedBy(func(in usecase.PatchItemInput) bool {
-					return in.Name != nil && *in.Name == "Updated"
-				})).Return((*entity.Item)(nil), domainErrors.ErrItemNotFound)
-			},
-			wantStatus: http.StatusNotFound,
-			wantError:  &ErrorResponse{Error: "item not found"},
-		},
-		{
-			name:    "validation error",
-			idParam: "1",
-			body:    `{"name":""}`,
-			setupMock: func(m *MockItemUsecase) {
-				m.On("PatchItem", mock.Anything, int64(1), mock.Anything).Return((*entity.Item)(nil), domainErrors.ErrInvalidInput)
-			},
-			wantStatus: http.StatusBadRequest,
-			wantError:  &ErrorResponse{Error: "validation failed", Details: []string{domainErrors.ErrInvalidInput.Error()}},
-		},
-		{
-			name:    "success",
-			idParam: "1",
-			body:    `{"name":"Updated"}`,
-			setupMock: func(m *MockItemUsecase) {
-				m.On("PatchItem", mock.Anything, int64(1), mock.MatchedBy(func(in usecase.PatchItemInput) bool {
-					return in.Name != nil && *in.Name == "Updated"
-				})).Return(updatedItem, nil)
-			},
-			wantStatus: http.StatusOK,
-			wantItem:   updatedItem,
-		},
-	}
+	s.mockUsecase.On("PatchItem", mock.Anything, int64(1), mock.MatchedBy(func(in usecase.PatchItemInput) bool {
+		return in.Name != nil && *in.Name == "Updated"
+	})).Return(updatedItem, nil)
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			mockUsecase := new(MockItemUsecase)
-			tt.setupMock(mockUsecase)
-
-			handler := NewItemHandler(mockUsecase)
-			c, rec := newPatchContext(t, tt.body, tt.idParam)
-
-			err := handler.PatchItem(c)
-			require.NoError(t, err)
-			assert.Equal(t, tt.wantStatus, rec.Code)
-
-			if tt.assertNoCall {
-				mockUsecase.AssertNotCalled(t, "PatchItem", mock.Anything, mock.Anything, mock.Anything)
-			}
-
-			if tt.wantError != nil {
-				var got ErrorResponse
-				require.NoError(t, json.NewDecoder(rec.Body).Decode(&got))
-				assert.Equal(t, tt.wantError.Error, got.Error)
-				assert.Equal(t, tt.wantError.Details, got.Details)
-			}
-
-			if tt.wantItem != nil {
-				var got entity.Item
-				require.NoError(t, json.NewDecoder(rec.Body).Decode(&got))
-				assert.Equal(t, tt.wantItem.ID, got.ID)
-				assert.Equal(t, tt.wantItem.Name, got.Name)
-				assert.Equal(t, tt.wantItem.Brand, got.Brand)
-				assert.Equal(t, tt.wantItem.Category, got.Category)
-				assert.Equal(t, tt.wantItem.PurchasePrice, got.PurchasePrice)
-				assert.Equal(t, tt.wantItem.PurchaseDate, got.PurchaseDate)
-			}
-
-			mockUsecase.AssertExpectations(t)
-		})
-	}
+	c, rec := s.newPatchContext(`{"name":"Updated"}`, "1")
+
+	s.Require().NoError(s.handler.PatchItem(c))
+	s.Equal(http.StatusOK, rec.Code)
+
+	var got entity.Item
+	s.Require().NoError(json.NewDecoder(rec.Body).Decode(&got))
+	s.Equal(updatedItem.ID, got.ID)
+	s.Equal(updatedItem.Name, got.Name)
+	s.Equal(updatedItem.Brand, got.Brand)
+	s.Equal(updatedItem.Category, got.Category)
+	s.Equal(updatedItem.PurchasePrice, got.PurchasePrice)
+	s.Equal(updatedItem.PurchaseDate, got.PurchaseDate)
+}
+
+func TestItemHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(ItemHandlerTestSuite))
 }
@@ -0,0 +1,143 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// ItemRepository persists items through a Querier. Passing the base
+// SqlHandler runs its methods directly against the database; passing a
+// TxHandler scopes them to that transaction instead.
+type ItemRepository struct {
+	SqlHandler Querier
+}
+
+// NewItemRepository builds an ItemRepository over the given Querier.
+func NewItemRepository(q Querier) *ItemRepository {
+	return &ItemRepository{SqlHandler: q}
+}
+
+func (r *ItemRepository) Create(ctx context.Context, item *entity.Item) (*entity.Item, error) {
+	result, err := r.SqlHandler.Execute(ctx,
+		`INSERT INTO items (name, category, brand, purchase_price, purchase_date, created_at, updated_at)
+		 VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		item.Name, item.Category, item.Brand, item.PurchasePrice, item.PurchaseDate, item.CreatedAt, item.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("create item: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("create item: %w", err)
+	}
+
+	item.ID = id
+	return item, nil
+}
+
+func (r *ItemRepository) FindAll(ctx context.Context) ([]*entity.Item, error) {
+	rows, err := r.SqlHandler.Query(ctx,
+		`SELECT id, name, category, brand, purchase_price, purchase_date, created_at, updated_at
+		 FROM items ORDER BY id`)
+	if err != nil {
+		return nil, fmt.Errorf("find all items: %w", err)
+	}
+	defer rows.Close()
+
+	var items []*entity.Item
+	for rows.Next() {
+		item := &entity.Item{}
+		if err := rows.Scan(&item.ID, &item.Name, &item.Category, &item.Brand,
+			&item.PurchasePrice, &item.PurchaseDate, &item.CreatedAt, &item.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("find all items: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("find all items: %w", err)
+	}
+
+	return items, nil
+}
+
+func (r *ItemRepository) FindByID(ctx context.Context, id int64) (*entity.Item, error) {
+	row := r.SqlHandler.QueryRow(ctx,
+		`SELECT id, name, category, brand, purchase_price, purchase_date, created_at, updated_at
+		 FROM items WHERE id = ?`, id)
+
+	item := &entity.Item{}
+	err := row.Scan(&item.ID, &item.Name, &item.Category, &item.Brand,
+		&item.PurchasePrice, &item.PurchaseDate, &item.CreatedAt, &item.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, domainErrors.ErrItemNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("find item: %w", err)
+	}
+
+	return item, nil
+}
+
+func (r *ItemRepository) Update(ctx context.Context, item *entity.Item) error {
+	result, err := r.SqlHandler.Execute(ctx,
+		`UPDATE items SET name = ?, category = ?, brand = ?, purchase_price = ?, purchase_date = ?, updated_at = ?
+		 WHERE id = ?`,
+		item.Name, item.Category, item.Brand, item.PurchasePrice, item.PurchaseDate, item.UpdatedAt, item.ID)
+	if err != nil {
+		return fmt.Errorf("update item: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("update item: %w", err)
+	}
+	if affected == 0 {
+		return domainErrors.ErrItemNotFound
+	}
+
+	return nil
+}
+
+func (r *ItemRepository) Delete(ctx context.Context, id int64) error {
+	result, err := r.SqlHandler.Execute(ctx, `DELETE FROM items WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("delete item: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("delete item: %w", err)
+	}
+	if affected == 0 {
+		return domainErrors.ErrItemNotFound
+	}
+
+	return nil
+}
+
+func (r *ItemRepository) GetSummaryByCategory(ctx context.Context) (map[string]int, error) {
+	rows, err := r.SqlHandler.Query(ctx, `SELECT category, COUNT(*) FROM items GROUP BY category`)
+	if err != nil {
+		return nil, fmt.Errorf("get summary by category: %w", err)
+	}
+	defer rows.Close()
+
+	summary := make(map[string]int)
+	for rows.Next() {
+		var category string
+		var count int
+		if err := rows.Scan(&category, &count); err != nil {
+			return nil, fmt.Errorf("get summary by category: %w", err)
+		}
+		summary[category] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get summary by category: %w", err)
+	}
+
+	return summary, nil
+}
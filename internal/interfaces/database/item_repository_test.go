@@ -0,0 +1,233 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sqlmockHandler adapts a *sql.DB (backed by go-sqlmock) to the Querier
+// interface so ItemRepository can be exercised without a live database. It has
+// no BeginTx, so it does not satisfy the full SqlHandler interface.
+type sqlmockHandler struct {
+	db *sql.DB
+}
+
+func (h *sqlmockHandler) Execute(ctx context.Context, statement string, args ...interface{}) (Result, error) {
+	result, err := h.db.ExecContext(ctx, statement, args...)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (h *sqlmockHandler) Query(ctx context.Context, statement string, args ...interface{}) (Rows, error) {
+	rows, err := h.db.QueryContext(ctx, statement, args...)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func (h *sqlmockHandler) QueryRow(ctx context.Context, statement string, args ...interface{}) Row {
+	return h.db.QueryRowContext(ctx, statement, args...)
+}
+
+func (h *sqlmockHandler) Close() error {
+	return h.db.Close()
+}
+
+func newMockRepository(t *testing.T) (*ItemRepository, sqlmock.Sqlmock) {
+	t.Helper()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	return &ItemRepository{SqlHandler: &sqlmockHandler{db: db}}, mock
+}
+
+func TestItemRepository_Create(t *testing.T) {
+	repo, mock := newMockRepository(t)
+
+	item := &entity.Item{
+		Name:          "テスト",
+		Category:      "時計",
+		Brand:         "ROLEX",
+		PurchasePrice: 1000,
+		PurchaseDate:  "2023-01-01",
+		CreatedAt:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	mock.ExpectExec(regexp.QuoteMeta(
+		`INSERT INTO items (name, category, brand, purchase_price, purchase_date, created_at, updated_at)
+		 VALUES (?, ?, ?, ?, ?, ?, ?)`)).
+		WithArgs(item.Name, item.Category, item.Brand, item.PurchasePrice, item.PurchaseDate, item.CreatedAt, item.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	created, err := repo.Create(context.Background(), item)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), created.ID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestItemRepository_Create_DriverError(t *testing.T) {
+	repo, mock := newMockRepository(t)
+
+	item := &entity.Item{Name: "テスト", Category: "時計", Brand: "ROLEX", PurchasePrice: 1000, PurchaseDate: "2023-01-01"}
+
+	driverErr := errors.New("connection refused")
+	mock.ExpectExec(regexp.QuoteMeta(
+		`INSERT INTO items (name, category, brand, purchase_price, purchase_date, created_at, updated_at)
+		 VALUES (?, ?, ?, ?, ?, ?, ?)`)).
+		WithArgs(item.Name, item.Category, item.Brand, item.PurchasePrice, item.PurchaseDate, item.CreatedAt, item.UpdatedAt).
+		WillReturnError(driverErr)
+
+	_, err := repo.Create(context.Background(), item)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, driverErr)
+	assert.Contains(t, err.Error(), "create item")
+	require.NotEqual(t, driverErr, err, "Create must wrap the driver error, not return it verbatim")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestItemRepository_FindByID(t *testing.T) {
+	repo, mock := newMockRepository(t)
+
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	rows := sqlmock.NewRows([]string{"id", "name", "category", "brand", "purchase_price", "purchase_date", "created_at", "updated_at"}).
+		AddRow(int64(1), "テスト", "時計", "ROLEX", 1000, "2023-01-01", now, now)
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT id, name, category, brand, purchase_price, purchase_date, created_at, updated_at
+		 FROM items WHERE id = ?`)).
+		WithArgs(int64(1)).
+		WillReturnRows(rows)
+
+	item, err := repo.FindByID(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "テスト", item.Name)
+	assert.Equal(t, "時計", item.Category)
+	assert.Equal(t, "ROLEX", item.Brand)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestItemRepository_FindByID_NotFound(t *testing.T) {
+	repo, mock := newMockRepository(t)
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT id, name, category, brand, purchase_price, purchase_date, created_at, updated_at
+		 FROM items WHERE id = ?`)).
+		WithArgs(int64(1)).
+		WillReturnError(sql.ErrNoRows)
+
+	_, err := repo.FindByID(context.Background(), 1)
+	assert.ErrorIs(t, err, domainErrors.ErrItemNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestItemRepository_Update(t *testing.T) {
+	repo, mock := newMockRepository(t)
+
+	item := &entity.Item{
+		ID:            1,
+		Name:          "更新後",
+		Category:      "時計",
+		Brand:         "CHANEL",
+		PurchasePrice: 2000,
+		PurchaseDate:  "2023-01-01",
+		UpdatedAt:     time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE items SET name = ?, category = ?, brand = ?, purchase_price = ?, purchase_date = ?, updated_at = ?
+		 WHERE id = ?`)).
+		WithArgs(item.Name, item.Category, item.Brand, item.PurchasePrice, item.PurchaseDate, item.UpdatedAt, item.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, repo.Update(context.Background(), item))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestItemRepository_Update_NotFound(t *testing.T) {
+	repo, mock := newMockRepository(t)
+
+	item := &entity.Item{ID: 99, Name: "更新後", Category: "時計", Brand: "CHANEL", PurchasePrice: 2000, PurchaseDate: "2023-01-01"}
+
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE items SET name = ?, category = ?, brand = ?, purchase_price = ?, purchase_date = ?, updated_at = ?
+		 WHERE id = ?`)).
+		WithArgs(item.Name, item.Category, item.Brand, item.PurchasePrice, item.PurchaseDate, item.UpdatedAt, item.ID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := repo.Update(context.Background(), item)
+	assert.ErrorIs(t, err, domainErrors.ErrItemNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestItemRepository_Delete(t *testing.T) {
+	repo, mock := newMockRepository(t)
+
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM items WHERE id = ?`)).
+		WithArgs(int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, repo.Delete(context.Background(), 1))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestItemRepository_Delete_NotFound(t *testing.T) {
+	repo, mock := newMockRepository(t)
+
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM items WHERE id = ?`)).
+		WithArgs(int64(99)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := repo.Delete(context.Background(), 99)
+	assert.ErrorIs(t, err, domainErrors.ErrItemNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestItemRepository_Delete_DriverError(t *testing.T) {
+	repo, mock := newMockRepository(t)
+
+	driverErr := errors.New("connection refused")
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM items WHERE id = ?`)).
+		WithArgs(int64(1)).
+		WillReturnError(driverErr)
+
+	err := repo.Delete(context.Background(), 1)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, driverErr)
+	assert.Contains(t, err.Error(), "delete item")
+	require.NotEqual(t, driverErr, err, "Delete must wrap the driver error, not return it verbatim")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestItemRepository_GetSummaryByCategory(t *testing.T) {
+	repo, mock := newMockRepository(t)
+
+	rows := sqlmock.NewRows([]string{"category", "count"}).
+		AddRow("時計", 2).
+		AddRow("バッグ", 1)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT category, COUNT(*) FROM items GROUP BY category`)).
+		WillReturnRows(rows)
+
+	summary, err := repo.GetSummaryByCategory(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, summary["時計"])
+	assert.Equal(t, 1, summary["バッグ"])
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -1,120 +1,30 @@
 //go:build integration
 
-package database
+// Package database_test is an external test package (rather than an
+// internal one) so it can depend on internal/testutil, which itself wraps
+// the SqlHandler interface defined in this package — an internal test file
+// here would create an import cycle through testutil.
+package database_test
 
 import (
 	"context"
-	"database/sql"
-	"os"
 	"testing"
 	"time"
 
-	domainErrors "Aicon-assignment/internal/domain/errors"
 	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+	infradb "Aicon-assignment/internal/interfaces/database"
+	"Aicon-assignment/internal/testutil"
 
-	_ "github.com/go-sql-driver/mysql"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-type testSqlHandler struct {
-	db *sql.DB
-}
-
-func (h *testSqlHandler) Execute(ctx context.Context, statement string, args ...interface{}) (Result, error) {
-	result, err := h.db.ExecContext(ctx, statement, args...)
-	if err != nil {
-		return nil, err
-	}
-	return &testResult{result: result}, nil
-}
-
-func (h *testSqlHandler) Query(ctx context.Context, statement string, args ...interface{}) (Rows, error) {
-	rows, err := h.db.QueryContext(ctx, statement, args...)
-	if err != nil {
-		return nil, err
-	}
-	return &testRows{rows: rows}, nil
-}
-
-func (h *testSqlHandler) QueryRow(ctx context.Context, statement string, args ...interface{}) Row {
-	return &testRow{row: h.db.QueryRowContext(ctx, statement, args...)}
-}
-
-func (h *testSqlHandler) Close() error {
-	return h.db.Close()
-}
-
-type testResult struct {
-	result sql.Result
-}
-
-func (r *testResult) LastInsertId() (int64, error) {
-	return r.result.LastInsertId()
-}
-
-func (r *testResult) RowsAffected() (int64, error) {
-	return r.result.RowsAffected()
-}
-
-type testRows struct {
-	rows *sql.Rows
-}
-
-func (r *testRows) Next() bool {
-	return r.rows.Next()
-}
-
-func (r *testRows) Scan(dest ...interface{}) error {
-	return r.rows.Scan(dest...)
-}
-
-func (r *testRows) Close() error {
-	return r.rows.Close()
-}
-
-func (r *testRows) Err() error {
-	return r.rows.Err()
-}
-
-type testRow struct {
-	row *sql.Row
-}
-
-func (r *testRow) Scan(dest ...interface{}) error {
-	return r.row.Scan(dest...)
-}
-
-func openTestDB(t *testing.T) *sql.DB {
-	t.Helper()
-
-	dsn := os.Getenv("TEST_DB_DSN")
-	if dsn == "" {
-		t.Skip("TEST_DB_DSN is not set")
-	}
-
-	db, err := sql.Open("mysql", dsn)
-	require.NoError(t, err)
-	require.NoError(t, db.Ping())
-
-	t.Cleanup(func() {
-		_ = db.Close()
-	})
-
-	return db
-}
-
-func resetItemsTable(t *testing.T, db *sql.DB) {
-	t.Helper()
-	_, err := db.ExecContext(context.Background(), "TRUNCATE TABLE items")
-	require.NoError(t, err)
-}
-
 func TestItemRepository_CreateAndFindByID(t *testing.T) {
-	db := openTestDB(t)
-	resetItemsTable(t, db)
+	db := testutil.OpenDB(t)
+	testutil.ResetItemsTable(t, db)
 
-	repo := &ItemRepository{SqlHandler: &testSqlHandler{db: db}}
+	repo := infradb.NewItemRepository(&testutil.SqlHandler{DB: db})
 	ctx := context.Background()
 
 	item, err := entity.NewItem("テスト", "時計", "ROLEX", 1000, "2023-01-01")
@@ -136,10 +46,10 @@ func TestItemRepository_CreateAndFindByID(t *testing.T) {
 }
 
 func TestItemRepository_Update(t *testing.T) {
-	db := openTestDB(t)
-	resetItemsTable(t, db)
+	db := testutil.OpenDB(t)
+	testutil.ResetItemsTable(t, db)
 
-	repo := &ItemRepository{SqlHandler: &testSqlHandler{db: db}}
+	repo := infradb.NewItemRepository(&testutil.SqlHandler{DB: db})
 	ctx := context.Background()
 
 	item, err := entity.NewItem("元の名前", "時計", "ROLEX", 1000, "2023-01-01")
@@ -163,10 +73,10 @@ func TestItemRepository_Update(t *testing.T) {
 }
 
 func TestItemRepository_Delete(t *testing.T) {
-	db := openTestDB(t)
-	resetItemsTable(t, db)
+	db := testutil.OpenDB(t)
+	testutil.ResetItemsTable(t, db)
 
-	repo := &ItemRepository{SqlHandler: &testSqlHandler{db: db}}
+	repo := infradb.NewItemRepository(&testutil.SqlHandler{DB: db})
 	ctx := context.Background()
 
 	item, err := entity.NewItem("テスト", "時計", "ROLEX", 1000, "2023-01-01")
@@ -182,10 +92,10 @@ func TestItemRepository_Delete(t *testing.T) {
 }
 
 func TestItemRepository_GetSummaryByCategory(t *testing.T) {
-	db := openTestDB(t)
-	resetItemsTable(t, db)
+	db := testutil.OpenDB(t)
+	testutil.ResetItemsTable(t, db)
 
-	repo := &ItemRepository{SqlHandler: &testSqlHandler{db: db}}
+	repo := infradb.NewItemRepository(&testutil.SqlHandler{DB: db})
 	ctx := context.Background()
 
 	items := []struct {
@@ -0,0 +1,52 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+)
+
+//go:generate mockery --name=SqlHandler --dir=. --output=../../mocks --outpkg=mocks
+//go:generate mockery --name=TxHandler --dir=. --output=../../mocks --outpkg=mocks
+
+// Querier is the minimal set of operations ItemRepository needs to run a
+// query. Both SqlHandler and TxHandler satisfy it, so a repository built on
+// top of it can run unmodified outside or inside a transaction.
+type Querier interface {
+	Execute(ctx context.Context, statement string, args ...interface{}) (Result, error)
+	Query(ctx context.Context, statement string, args ...interface{}) (Rows, error)
+	QueryRow(ctx context.Context, statement string, args ...interface{}) Row
+}
+
+// SqlHandler abstracts database/sql so repositories can be exercised against
+// fakes (go-sqlmock, mockery) without a live connection.
+type SqlHandler interface {
+	Querier
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (TxHandler, error)
+	Close() error
+}
+
+// TxHandler is a Querier scoped to a single in-flight transaction.
+type TxHandler interface {
+	Querier
+	Commit() error
+	Rollback() error
+}
+
+// Result mirrors sql.Result.
+type Result interface {
+	LastInsertId() (int64, error)
+	RowsAffected() (int64, error)
+}
+
+// Rows mirrors the subset of *sql.Rows repositories need.
+type Rows interface {
+	Next() bool
+	Scan(dest ...interface{}) error
+	Close() error
+	Err() error
+}
+
+// Row mirrors *sql.Row.
+type Row interface {
+	Scan(dest ...interface{}) error
+}
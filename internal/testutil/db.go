@@ -0,0 +1,41 @@
+//go:build integration
+
+package testutil
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// OpenDB opens the integration test database from TEST_DB_DSN, skipping the
+// calling test if it isn't set.
+func OpenDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	dsn := os.Getenv("TEST_DB_DSN")
+	if dsn == "" {
+		t.Skip("TEST_DB_DSN is not set")
+	}
+
+	db, err := sql.Open("mysql", dsn)
+	require.NoError(t, err)
+	require.NoError(t, db.Ping())
+
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	return db
+}
+
+// ResetItemsTable truncates the items table so each test starts from a known
+// empty state.
+func ResetItemsTable(t *testing.T, db *sql.DB) {
+	t.Helper()
+	_, err := db.ExecContext(context.Background(), "TRUNCATE TABLE items")
+	require.NoError(t, err)
+}
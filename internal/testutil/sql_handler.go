@@ -0,0 +1,69 @@
+//go:build integration
+
+// Package testutil holds fixtures shared by the integration test suites
+// under the database, infrastructure/database, and controller packages so
+// each one isn't re-typing its own SqlHandler/TxHandler adapter.
+package testutil
+
+import (
+	"context"
+	"database/sql"
+
+	infradb "Aicon-assignment/internal/interfaces/database"
+
+	_ "github.com/go-sql-driver/mysql"
+)
+
+// SqlHandler adapts a *sql.DB to infradb.SqlHandler so integration tests can
+// wire the real repository, unit of work, and handler together.
+type SqlHandler struct {
+	DB *sql.DB
+}
+
+func (h *SqlHandler) Execute(ctx context.Context, statement string, args ...interface{}) (infradb.Result, error) {
+	return h.DB.ExecContext(ctx, statement, args...)
+}
+
+func (h *SqlHandler) Query(ctx context.Context, statement string, args ...interface{}) (infradb.Rows, error) {
+	return h.DB.QueryContext(ctx, statement, args...)
+}
+
+func (h *SqlHandler) QueryRow(ctx context.Context, statement string, args ...interface{}) infradb.Row {
+	return h.DB.QueryRowContext(ctx, statement, args...)
+}
+
+func (h *SqlHandler) BeginTx(ctx context.Context, opts *sql.TxOptions) (infradb.TxHandler, error) {
+	tx, err := h.DB.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &txHandler{tx: tx}, nil
+}
+
+func (h *SqlHandler) Close() error {
+	return h.DB.Close()
+}
+
+type txHandler struct {
+	tx *sql.Tx
+}
+
+func (h *txHandler) Execute(ctx context.Context, statement string, args ...interface{}) (infradb.Result, error) {
+	return h.tx.ExecContext(ctx, statement, args...)
+}
+
+func (h *txHandler) Query(ctx context.Context, statement string, args ...interface{}) (infradb.Rows, error) {
+	return h.tx.QueryContext(ctx, statement, args...)
+}
+
+func (h *txHandler) QueryRow(ctx context.Context, statement string, args ...interface{}) infradb.Row {
+	return h.tx.QueryRowContext(ctx, statement, args...)
+}
+
+func (h *txHandler) Commit() error {
+	return h.tx.Commit()
+}
+
+func (h *txHandler) Rollback() error {
+	return h.tx.Rollback()
+}
@@ -0,0 +1,20 @@
+package usecase
+
+import (
+	"context"
+
+	"Aicon-assignment/internal/domain/entity"
+)
+
+//go:generate mockery --name=ItemRepository --dir=. --output=../mocks --outpkg=mocks
+
+// ItemRepository is the persistence port the usecase layer depends on.
+// The interfaces/database package provides the concrete implementation.
+type ItemRepository interface {
+	Create(ctx context.Context, item *entity.Item) (*entity.Item, error)
+	FindAll(ctx context.Context) ([]*entity.Item, error)
+	FindByID(ctx context.Context, id int64) (*entity.Item, error)
+	Update(ctx context.Context, item *entity.Item) error
+	Delete(ctx context.Context, id int64) error
+	GetSummaryByCategory(ctx context.Context) (map[string]int, error)
+}
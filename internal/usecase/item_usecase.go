@@ -0,0 +1,148 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"Aicon-assignment/internal/domain/entity"
+)
+
+//go:generate mockery --name=ItemUsecase --dir=. --output=../mocks --outpkg=mocks
+
+// ItemUsecase defines the application operations available on items.
+type ItemUsecase interface {
+	GetAllItems(ctx context.Context) ([]*entity.Item, error)
+	GetItemByID(ctx context.Context, id int64) (*entity.Item, error)
+	CreateItem(ctx context.Context, input CreateItemInput) (*entity.Item, error)
+	DeleteItem(ctx context.Context, id int64) error
+	GetCategorySummary(ctx context.Context) (*CategorySummary, error)
+	PatchItem(ctx context.Context, id int64, input PatchItemInput) (*entity.Item, error)
+}
+
+// CreateItemInput carries the fields required to register a new item.
+type CreateItemInput struct {
+	Name          string
+	Category      string
+	Brand         string
+	PurchasePrice int
+	PurchaseDate  string
+}
+
+// PatchItemInput carries the fields to apply to an existing item. Nil fields
+// are left untouched so callers can submit a partial update.
+type PatchItemInput struct {
+	Name          *string `json:"name"`
+	Category      *string `json:"category"`
+	Brand         *string `json:"brand"`
+	PurchasePrice *int    `json:"purchase_price"`
+	PurchaseDate  *string `json:"purchase_date"`
+}
+
+// CategorySummary aggregates how many items fall under each category.
+type CategorySummary struct {
+	Categories map[string]int `json:"categories"`
+	Total      int            `json:"total"`
+}
+
+type itemUsecase struct {
+	repo ItemRepository
+	uow  UnitOfWork
+}
+
+// NewItemUsecase constructs an ItemUsecase backed by the given repository.
+// Writes that must be atomic are run through uow instead of repo directly.
+func NewItemUsecase(repo ItemRepository, uow UnitOfWork) ItemUsecase {
+	return &itemUsecase{repo: repo, uow: uow}
+}
+
+func (u *itemUsecase) GetAllItems(ctx context.Context) ([]*entity.Item, error) {
+	return u.repo.FindAll(ctx)
+}
+
+func (u *itemUsecase) GetItemByID(ctx context.Context, id int64) (*entity.Item, error) {
+	return u.repo.FindByID(ctx, id)
+}
+
+func (u *itemUsecase) CreateItem(ctx context.Context, input CreateItemInput) (*entity.Item, error) {
+	item, err := entity.NewItem(input.Name, input.Category, input.Brand, input.PurchasePrice, input.PurchaseDate)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.uow.Do(ctx, func(ctx context.Context, repo ItemRepository) error {
+		created, err := repo.Create(ctx, item)
+		if err != nil {
+			return err
+		}
+		item = created
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+func (u *itemUsecase) DeleteItem(ctx context.Context, id int64) error {
+	return u.repo.Delete(ctx, id)
+}
+
+func (u *itemUsecase) GetCategorySummary(ctx context.Context) (*CategorySummary, error) {
+	counts, err := u.repo.GetSummaryByCategory(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	total := 0
+	for _, count := range counts {
+		total += count
+	}
+
+	return &CategorySummary{Categories: counts, Total: total}, nil
+}
+
+func (u *itemUsecase) PatchItem(ctx context.Context, id int64, input PatchItemInput) (*entity.Item, error) {
+	var item *entity.Item
+
+	err := u.uow.Do(ctx, func(ctx context.Context, repo ItemRepository) error {
+		existing, err := repo.FindByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if input.Name != nil {
+			existing.Name = *input.Name
+		}
+		if input.Category != nil {
+			existing.Category = *input.Category
+		}
+		if input.Brand != nil {
+			existing.Brand = *input.Brand
+		}
+		if input.PurchasePrice != nil {
+			existing.PurchasePrice = *input.PurchasePrice
+		}
+		if input.PurchaseDate != nil {
+			existing.PurchaseDate = *input.PurchaseDate
+		}
+
+		if err := existing.Validate(); err != nil {
+			return err
+		}
+
+		existing.UpdatedAt = time.Now()
+
+		if err := repo.Update(ctx, existing); err != nil {
+			return err
+		}
+
+		item = existing
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
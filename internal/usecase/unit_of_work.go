@@ -0,0 +1,12 @@
+package usecase
+
+import "context"
+
+//go:generate mockery --name=UnitOfWork --dir=. --output=../mocks --outpkg=mocks
+
+// UnitOfWork runs fn atomically, handing it repositories scoped to a single
+// transaction. The transaction commits if fn returns nil and rolls back
+// otherwise, so multi-step usecases stay consistent if a later step fails.
+type UnitOfWork interface {
+	Do(ctx context.Context, fn func(ctx context.Context, repo ItemRepository) error) error
+}